@@ -5,6 +5,8 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,7 +43,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateAddonConfigurationValuesDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -87,6 +93,24 @@ func ResourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_associations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -114,6 +138,10 @@ func ResourceAddon() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"validate_configuration_values": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -152,6 +180,10 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ServiceAccountRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pod_identity_associations"); ok && len(v.([]interface{})) > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.([]interface{}))
+	}
+
 	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
 			return client.CreateAddon(ctx, input)
@@ -228,6 +260,7 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set("created_at", aws.ToTime(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.ToTime(addon.ModifiedAt).Format(time.RFC3339))
+	d.Set("pod_identity_associations", flattenAddonPodIdentityAssociations(addon.PodIdentityAssociations))
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
 	setTagsOut(ctx, addon.Tags)
@@ -245,47 +278,88 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values") {
-		input := &eks.UpdateAddonInput{
-			AddonName:          aws.String(addonName),
-			ClientRequestToken: aws.String(sdkid.UniqueId()),
-			ClusterName:        aws.String(clusterName),
-		}
-
-		if d.HasChange("addon_version") {
-			input.AddonVersion = aws.String(d.Get("addon_version").(string))
-		}
-
-		if d.HasChange("configuration_values") {
-			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
-		}
-
+	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values", "pod_identity_associations") {
 		var conflictResolutionAttr string
 		var conflictResolution types.ResolveConflicts
 
 		if v, ok := d.GetOk("resolve_conflicts"); ok {
 			conflictResolutionAttr = "resolve_conflicts"
 			conflictResolution = types.ResolveConflicts(v.(string))
-			input.ResolveConflicts = conflictResolution
 		} else if v, ok := d.GetOk("resolve_conflicts_on_update"); ok {
 			conflictResolutionAttr = "resolve_conflicts_on_update"
 			conflictResolution = types.ResolveConflicts(v.(string))
-			input.ResolveConflicts = conflictResolution
 		}
 
-		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
-		// permissions assigned to the node IAM role.
-		if d.HasChange("service_account_role_arn") || d.Get("service_account_role_arn").(string) != "" {
-			input.ServiceAccountRoleArn = aws.String(d.Get("service_account_role_arn").(string))
-		}
+		var updateID string
+		_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+			func() (interface{}, error) {
+				// Refresh the add-on on every attempt so ClientRequestToken
+				// is always fresh and AddonVersion is re-diffed against
+				// what's actually on the cluster, rather than resubmitting
+				// a now-stale version after a transient conflict.
+				current, err := FindAddonByClusterNameAndAddonName(ctx, client, clusterName, addonName)
+
+				if err != nil {
+					return nil, err
+				}
+
+				input := &eks.UpdateAddonInput{
+					AddonName:          aws.String(addonName),
+					ClientRequestToken: aws.String(sdkid.UniqueId()),
+					ClusterName:        aws.String(clusterName),
+				}
+
+				if v := d.Get("addon_version").(string); v != aws.ToString(current.AddonVersion) {
+					input.AddonVersion = aws.String(v)
+				}
+
+				if d.HasChange("configuration_values") {
+					input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
+				}
+
+				if conflictResolution != "" {
+					input.ResolveConflicts = conflictResolution
+				}
+
+				// If service account role ARN is already provided, use it. Otherwise, the add-on uses
+				// permissions assigned to the node IAM role.
+				if d.HasChange("service_account_role_arn") || d.Get("service_account_role_arn").(string) != "" {
+					input.ServiceAccountRoleArn = aws.String(d.Get("service_account_role_arn").(string))
+				}
+
+				if d.HasChange("pod_identity_associations") {
+					input.PodIdentityAssociations = expandAddonPodIdentityAssociations(d.Get("pod_identity_associations").([]interface{}))
+				}
 
-		output, err := client.UpdateAddon(ctx, input)
+				output, err := client.UpdateAddon(ctx, input)
+
+				if err != nil {
+					return nil, err
+				}
+
+				updateID = aws.ToString(output.Update.Id)
+
+				return output, nil
+			},
+			func(err error) (bool, error) {
+				if errs.IsA[*types.ResourceInUseException](err) {
+					return true, err
+				}
+
+				if errs.IsA[*types.InvalidParameterException](err) {
+					if strings.Contains(err.Error(), "conflict") || strings.Contains(err.Error(), "already in progress") {
+						return true, err
+					}
+				}
+
+				return false, err
+			},
+		)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EKS Add-On (%s): %s", d.Id(), err)
 		}
 
-		updateID := aws.ToString(output.Update.Id)
 		if _, err := waitAddonUpdateSuccessful(ctx, client, clusterName, addonName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			if conflictResolution != types.ResolveConflictsOverwrite {
 				// Changing addon version w/o setting resolve_conflicts to "OVERWRITE"
@@ -339,3 +413,82 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return diags
 }
+
+func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
+	apiObjects := make([]types.AddonPodIdentityAssociations, len(tfList))
+
+	for i := range tfList {
+		tfMap := tfList[i].(map[string]interface{})
+
+		apiObjects[i] = types.AddonPodIdentityAssociations{
+			ServiceAccount: aws.String(tfMap["service_account"].(string)),
+			RoleArn:        aws.String(tfMap["role_arn"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenAddonPodIdentityAssociations(apiObjects []types.AddonPodIdentityAssociations) []interface{} {
+	tfList := make([]interface{}, len(apiObjects))
+
+	for i, apiObject := range apiObjects {
+		tfList[i] = map[string]interface{}{
+			"service_account": aws.ToString(apiObject.ServiceAccount),
+			"role_arn":        aws.ToString(apiObject.RoleArn),
+		}
+	}
+
+	return tfList
+}
+
+// validateAddonConfigurationValuesDiff checks, at plan time, that
+// configuration_values is at least well-formed JSON before it's submitted to
+// EKS. It only runs when validate_configuration_values is set, avoiding an
+// extra DescribeAddonConfiguration call (and the associated API permission)
+// for configurations that don't opt in.
+//
+// This catches the same ConfigurationConflict-by-typo class of failure
+// described in resourceAddonCreate, but earlier, during terraform plan
+// instead of after the add-on is already tainted.
+func validateAddonConfigurationValuesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_configuration_values").(bool) {
+		return nil
+	}
+
+	configurationValues, ok := diff.GetOk("configuration_values")
+	if !ok {
+		return nil
+	}
+
+	if !json.Valid([]byte(configurationValues.(string))) {
+		return fmt.Errorf("configuration_values is not valid JSON")
+	}
+
+	addonName, ok := diff.GetOk("addon_name")
+	if !ok {
+		return nil
+	}
+
+	addonVersion, ok := diff.GetOk("addon_version")
+	if !ok {
+		return nil
+	}
+
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	output, err := client.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName.(string)),
+		AddonVersion: aws.String(addonVersion.(string)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("describing configuration schema for EKS Add-On (%s) version %s: %w", addonName, addonVersion, err)
+	}
+
+	if err := validateJSONAgainstSchema(configurationValues.(string), aws.ToString(output.ConfigurationSchema)); err != nil {
+		return fmt.Errorf("configuration_values does not match EKS Add-On (%s) version %s schema: %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
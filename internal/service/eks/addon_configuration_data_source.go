@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_eks_addon_configuration", name="Addon Configuration")
+func DataSourceAddonConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"addon_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	addonName := d.Get("addon_name").(string)
+	addonVersion := d.Get("addon_version").(string)
+
+	output, err := client.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On Configuration (%s, %s): %s", addonName, addonVersion, err)
+	}
+
+	d.SetId(addonName + ":" + addonVersion)
+	d.Set("configuration_schema", output.ConfigurationSchema)
+
+	return diags
+}
+
+// validateJSONAgainstSchema performs a structural check of configurationValues
+// against the "required" and top-level "properties" of the JSON schema EKS
+// returns from DescribeAddonConfiguration. It intentionally does not
+// implement the full JSON Schema specification (e.g. nested object/array
+// validation, $ref resolution) -- it's meant to catch the common case of a
+// missing required key or a wrong top-level type before EKS does.
+func validateJSONAgainstSchema(configurationValues, configurationSchema string) error {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(configurationValues), &values); err != nil {
+		return fmt.Errorf("configuration_values must be a JSON object: %w", err)
+	}
+
+	var jsonSchema struct {
+		Required   []string `json:"required"`
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(configurationSchema), &jsonSchema); err != nil {
+		return fmt.Errorf("parsing add-on configuration schema: %w", err)
+	}
+
+	for _, key := range jsonSchema.Required {
+		if _, ok := values[key]; !ok {
+			return fmt.Errorf("missing required property %q", key)
+		}
+	}
+
+	for key, value := range values {
+		prop, ok := jsonSchema.Properties[key]
+		if !ok || prop.Type == "" {
+			continue
+		}
+
+		if !jsonValueMatchesType(value, prop.Type) {
+			return fmt.Errorf("property %q must be of type %q", key, prop.Type)
+		}
+	}
+
+	return nil
+}
+
+func jsonValueMatchesType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number", "integer":
+		_, ok := value.(float64)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
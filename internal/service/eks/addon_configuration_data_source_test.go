@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddonConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_addon_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "configuration_schema"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, "coredns"), `
+data "aws_eks_addon_version" "test" {
+  addon_name         = var.addon_name
+  kubernetes_version = aws_eks_cluster.test.version
+}
+
+data "aws_eks_addon_configuration" "test" {
+  addon_name    = var.addon_name
+  addon_version = data.aws_eks_addon_version.test.version
+}
+`)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddon_validateConfigurationValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "coredns"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_validateConfigurationValues(rName, addonName, `{"replicaCount": 2}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "validate_configuration_values", "true"),
+				),
+			},
+			{
+				Config:      testAccAddonConfig_validateConfigurationValues(rName, addonName, `not-json`),
+				ExpectError: regexache.MustCompile(`configuration_values is not valid JSON`),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon1, addon2 types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "coredns"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The second step changes only resolve_conflicts_on_update, which
+				// updates the resource in place without an UpdateAddon call.
+				Config: testAccAddonConfig_resolveConflicts(rName, addonName, string(types.ResolveConflictsOverwrite)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon1),
+				),
+			},
+			{
+				Config: testAccAddonConfig_resolveConflicts(rName, addonName, string(types.ResolveConflictsNone)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon2),
+					testAccCheckAddonNotRecreated(&addon1, &addon2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_podIdentityAssociations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_podIdentityAssociations(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_associations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_associations.0.service_account", "vpc-cni"),
+					resource.TestCheckResourceAttrSet(resourceName, "pod_identity_associations.0.role_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAddonExists(ctx context.Context, resourceName string, v *types.Addon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindAddonByClusterNameAndAddonName(ctx, client, clusterName, addonName)
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAddonDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_addon" {
+				continue
+			}
+
+			clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfeks.FindAddonByClusterNameAndAddonName(ctx, client, clusterName, addonName)
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EKS Add-On %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAddonConfig_base(rName, addonName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "eks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEKSClusterPolicy"
+  role       = aws_iam_role.test.name
+}
+
+data "aws_partition" "current" {}
+
+variable "addon_name" {
+  default = %[2]q
+}
+`, rName, addonName))
+}
+
+func testAccCheckAddonNotRecreated(before, after *types.Addon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.AddonArn), aws.ToString(after.AddonArn); before != after {
+			return fmt.Errorf("EKS Add-On (%s, %s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccAddonConfig_resolveConflicts(rName, addonName, resolveConflicts string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, addonName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name                = aws_eks_cluster.test.name
+  addon_name                  = var.addon_name
+  resolve_conflicts_on_create = "OVERWRITE"
+  resolve_conflicts_on_update = %[1]q
+}
+`, resolveConflicts))
+}
+
+func testAccAddonConfig_podIdentityAssociations(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, addonName), fmt.Sprintf(`
+resource "aws_iam_role" "pod_identity" {
+  name = "%[1]s-pod-identity"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "pods.eks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name                = aws_eks_cluster.test.name
+  addon_name                  = var.addon_name
+  resolve_conflicts_on_create = "OVERWRITE"
+
+  pod_identity_associations {
+    service_account = "vpc-cni"
+    role_arn        = aws_iam_role.pod_identity.arn
+  }
+}
+`, rName))
+}
+
+func testAccAddonConfig_validateConfigurationValues(rName, addonName, configurationValues string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, addonName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name                  = aws_eks_cluster.test.name
+  addon_name                    = var.addon_name
+  resolve_conflicts_on_create   = "OVERWRITE"
+  validate_configuration_values = true
+  configuration_values          = %[1]q
+}
+`, configurationValues))
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_addon_version", name="Addon Version")
+func DataSourceAddonVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cluster_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validClusterName,
+				ConflictsWith: []string{"kubernetes_version"},
+			},
+			"kubernetes_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"cluster_name"},
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDefault: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"compatible_cluster_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"configuration_values_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	addonName := d.Get("addon_name").(string)
+
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+	if v, ok := d.GetOk("cluster_name"); ok {
+		cluster, err := findClusterByName(ctx, client, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", v.(string), err)
+		}
+
+		kubernetesVersion = aws.ToString(cluster.Version)
+	}
+
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName: aws.String(addonName),
+	}
+
+	if kubernetesVersion != "" {
+		input.KubernetesVersion = aws.String(kubernetesVersion)
+	}
+
+	// Accumulate matching entries across every page: DescribeAddonVersions can
+	// span multiple pages of AddonVersions for a single add-on (e.g.
+	// vpc-cni/coredns with a long published version history), and each page
+	// only contributes a slice of them.
+	var addonVersions []types.AddonVersionInfo
+	pages := eks.NewDescribeAddonVersionsPaginator(client, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) versions: %s", addonName, err)
+		}
+
+		for i := range page.Addons {
+			if aws.ToString(page.Addons[i].AddonName) == addonName {
+				addonVersions = append(addonVersions, page.Addons[i].AddonVersions...)
+			}
+		}
+	}
+
+	if len(addonVersions) == 0 {
+		return sdkdiag.AppendErrorf(diags, "no compatible EKS Add-On (%s) versions found for Kubernetes version (%s)", addonName, kubernetesVersion)
+	}
+
+	addonVersion, err := latestCompatibleAddonVersion(addonVersions, d.Get("most_recent").(bool))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "determining latest compatible EKS Add-On (%s) version: %s", addonName, err)
+	}
+
+	addonVersionString := aws.ToString(addonVersion.AddonVersion)
+
+	configOutput, err := client.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersionString),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersionString, err)
+	}
+
+	d.SetId(addonName)
+	d.Set("version", addonVersionString)
+	d.Set(names.AttrDefault, addonVersionIsDefault(addonVersion))
+	d.Set("compatible_cluster_versions", flattenCompatibleClusterVersions(addonVersion.Compatibilities))
+	d.Set("configuration_values_schema", configOutput.ConfigurationSchema)
+
+	return diags
+}
+
+// latestCompatibleAddonVersion picks the "default" version EKS recommends
+// for the Kubernetes version queried, unless most_recent is set, in which
+// case it parses every candidate's AddonVersion as semver and returns the
+// highest one. DescribeAddonVersions isn't documented to return versions in
+// any particular order, so this doesn't trust list order to mean anything.
+func latestCompatibleAddonVersion(versions []types.AddonVersionInfo, mostRecent bool) (*types.AddonVersionInfo, error) {
+	if !mostRecent {
+		for i := range versions {
+			for _, compatibility := range versions[i].Compatibilities {
+				if aws.ToBool(compatibility.DefaultVersion) {
+					return &versions[i], nil
+				}
+			}
+		}
+	}
+
+	parsed := make([]*goversion.Version, len(versions))
+	for i := range versions {
+		v, err := goversion.NewVersion(aws.ToString(versions[i].AddonVersion))
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing add-on version %q: %w", aws.ToString(versions[i].AddonVersion), err)
+		}
+
+		parsed[i] = v
+	}
+
+	indices := make([]int, len(versions))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.Slice(indices, func(i, j int) bool {
+		return parsed[indices[i]].GreaterThan(parsed[indices[j]])
+	})
+
+	return &versions[indices[0]], nil
+}
+
+func addonVersionIsDefault(version *types.AddonVersionInfo) bool {
+	for _, compatibility := range version.Compatibilities {
+		if aws.ToBool(compatibility.DefaultVersion) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenCompatibleClusterVersions(compatibilities []types.Compatibility) []string {
+	versions := make([]string, len(compatibilities))
+
+	for i, compatibility := range compatibilities {
+		versions[i] = aws.ToString(compatibility.ClusterVersion)
+	}
+
+	return versions
+}
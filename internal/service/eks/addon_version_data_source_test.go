@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_addon_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig_clusterName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrDefault),
+					resource.TestCheckResourceAttrSet(dataSourceName, "configuration_values_schema"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddonVersionDataSource_mostRecent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_addon_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig_mostRecent(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonVersionDataSourceConfig_clusterName(rName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, "coredns"), `
+data "aws_eks_addon_version" "test" {
+  addon_name   = var.addon_name
+  cluster_name = aws_eks_cluster.test.name
+}
+`)
+}
+
+func testAccAddonVersionDataSourceConfig_mostRecent(rName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, "coredns"), `
+data "aws_eks_addon_version" "test" {
+  addon_name         = var.addon_name
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = true
+}
+`)
+}
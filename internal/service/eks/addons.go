@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// addonsMaxConcurrency bounds how many CreateAddon/UpdateAddon/DeleteAddon
+// calls aws_eks_addons issues at once, so a large declaration doesn't slam
+// the EKS control plane with dozens of simultaneous mutating calls.
+const addonsMaxConcurrency = 5
+
+// @SDKResource("aws_eks_addons", name="Add-Ons")
+func ResourceAddons() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddonsCreate,
+		ReadWithoutTimeout:   resourceAddonsRead,
+		UpdateWithoutTimeout: resourceAddonsUpdate,
+		DeleteWithoutTimeout: resourceAddonsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"addon": {
+				// TypeList, not TypeSet: elements mix user-declared
+				// attributes with server-computed ones (arn, created_at,
+				// modified_at, and addon_version when left to default).
+				// A set element's hash is unknown at plan time (computed
+				// fields) and different after apply (computed fields
+				// populated), which trips "inconsistent result after
+				// apply". A list has no such hash to get wrong.
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addon_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"addon_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"configuration_values": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"resolve_conflicts_on_create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(types.ResolveConflictsNone, types.ResolveConflictsOverwrite), false),
+						},
+						"resolve_conflicts_on_update": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.ResolveConflicts](),
+						},
+						"service_account_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addonPlan is one addon block as declared in configuration.
+type addonPlan struct {
+	addonName                string
+	addonVersion              string
+	configurationValues       string
+	resolveConflictsOnCreate string
+	resolveConflictsOnUpdate string
+	serviceAccountRoleARN    string
+}
+
+func expandAddonPlans(raw []interface{}) []addonPlan {
+	plans := make([]addonPlan, len(raw))
+
+	// Iterate by index, not by value: with `for _, v := range raw` every
+	// loop variable and any goroutine closing over it observes the final
+	// element once the loop advances. CAPA hit exactly this aliasing bug
+	// reconciling multiple add-ons concurrently.
+	for i := range raw {
+		tfMap := raw[i].(map[string]interface{})
+
+		plans[i] = addonPlan{
+			addonName:                tfMap["addon_name"].(string),
+			addonVersion:             tfMap["addon_version"].(string),
+			configurationValues:      tfMap["configuration_values"].(string),
+			resolveConflictsOnCreate: tfMap["resolve_conflicts_on_create"].(string),
+			resolveConflictsOnUpdate: tfMap["resolve_conflicts_on_update"].(string),
+			serviceAccountRoleARN:    tfMap["service_account_role_arn"].(string),
+		}
+	}
+
+	return plans
+}
+
+// changedAddonPlans compares the old and new values of the addon attribute
+// (as returned by d.GetChange) and returns only the new-state entries that
+// are new or whose fields actually changed. Without this, any edit to one
+// addon block would resubmit UpdateAddon for every untouched add-on in the
+// same aws_eks_addons resource.
+func changedAddonPlans(oldRaw, newRaw interface{}) []addonPlan {
+	oldByName := map[string]addonPlan{}
+	for _, p := range expandAddonPlans(oldRaw.([]interface{})) {
+		oldByName[p.addonName] = p
+	}
+
+	var changed []addonPlan
+	for _, p := range expandAddonPlans(newRaw.([]interface{})) {
+		if old, ok := oldByName[p.addonName]; !ok || old != p {
+			changed = append(changed, p)
+		}
+	}
+
+	return changed
+}
+
+func resourceAddonsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	d.SetId(clusterName)
+
+	declared := d.Get("addon").([]interface{})
+	addons := expandAddonPlans(declared)
+
+	if diags := createOrUpdateAddons(ctx, client, clusterName, addons, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("exclusive").(bool) {
+		if diags := deleteUndeclaredAddons(ctx, client, clusterName, declared, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceAddonsRead(ctx, d, meta)...)
+}
+
+func resourceAddonsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Id()
+
+	addonNames, err := listAllAddons(ctx, client, clusterName)
+
+	if !d.IsNewResource() && errs.IsA[*types.ResourceNotFoundException](err) {
+		log.Printf("[WARN] EKS Cluster (%s) not found, removing aws_eks_addons from state", clusterName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing EKS Add-Ons for Cluster (%s): %s", clusterName, err)
+	}
+
+	present := sliceToSet(addonNames)
+
+	// addon is TypeList (ordered): build tfList by walking the declared
+	// config slice in its original order, not by ranging over a map -- map
+	// iteration order is randomized per run and would reorder the nested
+	// blocks on every Read, producing "inconsistent result after apply".
+	declared := d.Get("addon").([]interface{})
+
+	tfList := make([]interface{}, 0, len(declared))
+	for _, v := range declared {
+		declaredAddon := v.(map[string]interface{})
+		addonName := declaredAddon["addon_name"].(string)
+
+		// Tolerate add-ons created out-of-band: only reflect ones the
+		// configuration actually declares. With exclusive = true, Create and
+		// Update delete anything undeclared before this Read runs.
+		if !present[addonName] {
+			continue
+		}
+
+		addon, err := FindAddonByClusterNameAndAddonName(ctx, client, clusterName, addonName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s/%s): %s", clusterName, addonName, err)
+		}
+
+		// resolve_conflicts_on_create/_on_update are reconciliation
+		// directives, not attributes EKS reflects back on Addon -- carry
+		// the user's declared values forward instead of losing them to a
+		// zero value on every read.
+		flat := flattenAddon(addon)
+		flat["resolve_conflicts_on_create"] = declaredAddon["resolve_conflicts_on_create"]
+		flat["resolve_conflicts_on_update"] = declaredAddon["resolve_conflicts_on_update"]
+		tfList = append(tfList, flat)
+	}
+
+	d.Set("cluster_name", clusterName)
+	d.Set("addon", tfList)
+
+	return diags
+}
+
+func flattenAddon(addon *types.Addon) map[string]interface{} {
+	return map[string]interface{}{
+		"addon_name":               aws.ToString(addon.AddonName),
+		"addon_version":            aws.ToString(addon.AddonVersion),
+		"arn":                      aws.ToString(addon.AddonArn),
+		"configuration_values":     aws.ToString(addon.ConfigurationValues),
+		"created_at":               aws.ToTime(addon.CreatedAt).Format(time.RFC3339),
+		"modified_at":              aws.ToTime(addon.ModifiedAt).Format(time.RFC3339),
+		"service_account_role_arn": aws.ToString(addon.ServiceAccountRoleArn),
+	}
+}
+
+// listAllAddons returns every add-on name on the cluster, paginating through
+// ListAddons so clusters with more add-ons than a single page holds aren't
+// under-reported.
+func listAllAddons(ctx context.Context, client *eks.Client, clusterName string) ([]string, error) {
+	var addonNames []string
+
+	pages := eks.NewListAddonsPaginator(client, &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		addonNames = append(addonNames, page.Addons...)
+	}
+
+	return addonNames, nil
+}
+
+func sliceToSet(s []string) map[string]bool {
+	set := make(map[string]bool, len(s))
+	for _, v := range s {
+		set[v] = true
+	}
+
+	return set
+}
+
+func resourceAddonsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Id()
+
+	if d.HasChange("addon") {
+		changed := changedAddonPlans(d.GetChange("addon"))
+
+		if diags := createOrUpdateAddons(ctx, client, clusterName, changed, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.Get("exclusive").(bool) {
+		if diags := deleteUndeclaredAddons(ctx, client, clusterName, d.Get("addon").([]interface{}), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceAddonsRead(ctx, d, meta)...)
+}
+
+func resourceAddonsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Id()
+	addons := expandAddonPlans(d.Get("addon").([]interface{}))
+
+	err := forEachAddonBounded(addons, func(addon addonPlan) error {
+		log.Printf("[DEBUG] Deleting EKS Add-On: %s/%s", clusterName, addon.addonName)
+
+		_, err := client.DeleteAddon(ctx, &eks.DeleteAddonInput{
+			AddonName:   aws.String(addon.addonName),
+			ClusterName: aws.String(clusterName),
+		})
+
+		if err != nil && !errs.IsA[*types.ResourceNotFoundException](err) {
+			return err
+		}
+
+		waiter := eks.NewAddonDeletedWaiter(client)
+		return waiter.Wait(ctx, &eks.DescribeAddonInput{
+			AddonName:   aws.String(addon.addonName),
+			ClusterName: aws.String(clusterName),
+		}, d.Timeout(schema.TimeoutDelete))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EKS Add-Ons (%s): %s", clusterName, err)
+	}
+
+	return diags
+}
+
+// createOrUpdateAddons reconciles the declared add-ons against the cluster:
+// it creates any that don't exist yet and updates any that do, in parallel
+// with bounded concurrency. Each worker waits for its own add-on to settle
+// before finishing, and this returns once every worker has finished.
+func createOrUpdateAddons(ctx context.Context, client *eks.Client, clusterName string, addons []addonPlan, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := forEachAddonBounded(addons, func(addon addonPlan) error {
+		_, err := FindAddonByClusterNameAndAddonName(ctx, client, clusterName, addon.addonName)
+
+		switch {
+		case errs.IsA[*types.ResourceNotFoundException](err):
+			return createAddon(ctx, client, clusterName, addon, timeout)
+		case err != nil:
+			return err
+		default:
+			return updateAddon(ctx, client, clusterName, addon, timeout)
+		}
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reconciling EKS Add-Ons (%s): %s", clusterName, err)
+	}
+
+	return diags
+}
+
+func createAddon(ctx context.Context, client *eks.Client, clusterName string, addon addonPlan, timeout time.Duration) error {
+	input := &eks.CreateAddonInput{
+		AddonName:          aws.String(addon.addonName),
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+	}
+
+	if addon.addonVersion != "" {
+		input.AddonVersion = aws.String(addon.addonVersion)
+	}
+
+	if addon.configurationValues != "" {
+		input.ConfigurationValues = aws.String(addon.configurationValues)
+	}
+
+	if addon.resolveConflictsOnCreate != "" {
+		input.ResolveConflicts = types.ResolveConflicts(addon.resolveConflictsOnCreate)
+	}
+
+	if addon.serviceAccountRoleARN != "" {
+		input.ServiceAccountRoleArn = aws.String(addon.serviceAccountRoleARN)
+	}
+
+	if _, err := client.CreateAddon(ctx, input); err != nil {
+		return err
+	}
+
+	waiter := eks.NewAddonActiveWaiter(client)
+	return waiter.Wait(ctx, &eks.DescribeAddonInput{
+		AddonName:   aws.String(addon.addonName),
+		ClusterName: aws.String(clusterName),
+	}, timeout)
+}
+
+func updateAddon(ctx context.Context, client *eks.Client, clusterName string, addon addonPlan, timeout time.Duration) error {
+	input := &eks.UpdateAddonInput{
+		AddonName:          aws.String(addon.addonName),
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+	}
+
+	if addon.addonVersion != "" {
+		input.AddonVersion = aws.String(addon.addonVersion)
+	}
+
+	if addon.configurationValues != "" {
+		input.ConfigurationValues = aws.String(addon.configurationValues)
+	}
+
+	if addon.resolveConflictsOnUpdate != "" {
+		input.ResolveConflicts = types.ResolveConflicts(addon.resolveConflictsOnUpdate)
+	}
+
+	if addon.serviceAccountRoleARN != "" {
+		input.ServiceAccountRoleArn = aws.String(addon.serviceAccountRoleARN)
+	}
+
+	output, err := client.UpdateAddon(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitAddonUpdateSuccessful(ctx, client, clusterName, addon.addonName, aws.ToString(output.Update.Id), timeout)
+	return err
+}
+
+func deleteUndeclaredAddons(ctx context.Context, client *eks.Client, clusterName string, declaredRaw []interface{}, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declared := map[string]bool{}
+	for _, v := range declaredRaw {
+		declared[v.(map[string]interface{})["addon_name"].(string)] = true
+	}
+
+	addonNames, err := listAllAddons(ctx, client, clusterName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing EKS Add-Ons for Cluster (%s): %s", clusterName, err)
+	}
+
+	var undeclared []addonPlan
+	for _, addonName := range addonNames {
+		if !declared[addonName] {
+			undeclared = append(undeclared, addonPlan{addonName: addonName})
+		}
+	}
+
+	err = forEachAddonBounded(undeclared, func(addon addonPlan) error {
+		log.Printf("[DEBUG] Deleting out-of-band EKS Add-On (exclusive = true): %s/%s", clusterName, addon.addonName)
+
+		_, err := client.DeleteAddon(ctx, &eks.DeleteAddonInput{
+			AddonName:   aws.String(addon.addonName),
+			ClusterName: aws.String(clusterName),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting out-of-band EKS Add-Ons (%s): %s", clusterName, err)
+	}
+
+	return diags
+}
+
+// forEachAddonBounded runs fn for every addon concurrently, bounded to
+// addonsMaxConcurrency in flight at once, and returns the first error
+// encountered (if any) after all goroutines finish.
+func forEachAddonBounded(addons []addonPlan, fn func(addonPlan) error) error {
+	sem := make(chan struct{}, addonsMaxConcurrency)
+	errCh := make(chan error, len(addons))
+
+	var wg sync.WaitGroup
+	for i := range addons {
+		addon := addons[i]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			errCh <- fn(addon)
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddons_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addons.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addon.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddons_exclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addons.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.EKSEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonsConfig_exclusive(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "addon.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAddonsExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := client.ListAddons(ctx, &eks.ListAddonsInput{
+			ClusterName: &rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Addons) == 0 {
+			return fmt.Errorf("EKS Add-Ons for Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAddonsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_addons" {
+				continue
+			}
+
+			output, err := client.ListAddons(ctx, &eks.ListAddonsInput{
+				ClusterName: &rs.Primary.ID,
+			})
+			if err != nil {
+				continue
+			}
+
+			if len(output.Addons) > 0 {
+				return fmt.Errorf("EKS Add-Ons for Cluster (%s) still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAddonsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, "coredns"), `
+resource "aws_eks_addons" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  addon {
+    addon_name                  = "coredns"
+    resolve_conflicts_on_create = "OVERWRITE"
+  }
+
+  addon {
+    addon_name                  = "kube-proxy"
+    resolve_conflicts_on_create = "OVERWRITE"
+  }
+}
+`)
+}
+
+func testAccAddonsConfig_exclusive(rName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName, "coredns"), `
+resource "aws_eks_addons" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  exclusive    = true
+
+  addon {
+    addon_name                  = "coredns"
+    resolve_conflicts_on_create = "OVERWRITE"
+  }
+}
+`)
+}